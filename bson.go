@@ -0,0 +1,294 @@
+package orderedmap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+var errBSONDocTooShort = fmt.Errorf("bson: document too short")
+var errBSONLengthMismatch = fmt.Errorf("bson: length prefix does not match document size")
+var errBSONMalformedKey = fmt.Errorf("bson: malformed element key")
+
+// bsonDocumentMarshaler is satisfied by any *orderedMap, regardless of its
+// type parameters, letting nested maps be dispatched generically.
+type bsonDocumentMarshaler interface {
+	MarshalBSON() ([]byte, error)
+}
+
+// bsonDocumentUnmarshaler is satisfied by any *orderedMap, regardless of its
+// type parameters, mirroring bsonDocumentMarshaler for the decode path.
+type bsonDocumentUnmarshaler interface {
+	UnmarshalBSON([]byte) error
+}
+
+// MarshalBSON encodes the map as a BSON document, preserving insertion
+// order, so it round-trips through mongo-go-driver without first converting
+// to bson.D.
+func (om *orderedMap[K, V]) MarshalBSON() ([]byte, error) {
+	if err := om.validateKey(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.Write(make([]byte, 4)) // length placeholder, backfilled below
+	for n := om.head; n != nil; n = n.next {
+		elemType, data, err := encodeBSONValue(n.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteByte(byte(elemType))
+		buf.WriteString(any(n.key).(string))
+		buf.WriteByte(0x00)
+		buf.Write(data)
+	}
+	buf.WriteByte(0x00)
+
+	out := buf.Bytes()
+	binary.LittleEndian.PutUint32(out[0:4], uint32(len(out)))
+	return out, nil
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler so the map can be embedded
+// as a document inside other BSON values.
+func (om *orderedMap[K, V]) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	data, err := om.MarshalBSON()
+	if err != nil {
+		return bsontype.Null, nil, err
+	}
+	return bsontype.EmbeddedDocument, data, nil
+}
+
+// UnmarshalBSON decodes a BSON document into the map, preserving element
+// order.
+func (om *orderedMap[K, V]) UnmarshalBSON(data []byte) error {
+	if err := om.validateKey(); err != nil {
+		return err
+	}
+	if len(data) < 5 {
+		return errBSONDocTooShort
+	}
+	if length := binary.LittleEndian.Uint32(data[0:4]); int(length) != len(data) {
+		return errBSONLengthMismatch
+	}
+
+	om.Clear()
+	decodeAsAny := isAnyType[V]()
+	var targetType reflect.Type
+	if !decodeAsAny {
+		var zero V
+		targetType = reflect.TypeOf(&zero).Elem()
+	}
+
+	pos := 4
+	for pos < len(data)-1 {
+		elemType := bsontype.Type(data[pos])
+		pos++
+
+		keyEnd := bytes.IndexByte(data[pos:], 0x00)
+		if keyEnd < 0 {
+			return errBSONMalformedKey
+		}
+		keyStr := string(data[pos : pos+keyEnd])
+		pos += keyEnd + 1
+
+		value, n, err := decodeBSONValue(elemType, data[pos:])
+		if err != nil {
+			return fmt.Errorf("error unmarshaling bson for key %s: %w", keyStr, err)
+		}
+		pos += n
+
+		key := any(keyStr).(K)
+		if decodeAsAny {
+			om.Set(key, any(value).(V))
+			continue
+		}
+
+		converted, err := convertBSONValue(value, targetType)
+		if err != nil {
+			return fmt.Errorf("error unmarshaling bson for key %s: %w", keyStr, err)
+		}
+		om.Set(key, converted.(V))
+	}
+	return nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+func (om *orderedMap[K, V]) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if t != bsontype.EmbeddedDocument {
+		return fmt.Errorf("bson: cannot unmarshal %s into orderedMap", t)
+	}
+	return om.UnmarshalBSON(data)
+}
+
+// encodeBSONValue dispatches on the dynamic type of v, producing the BSON
+// element type byte and its encoded payload.
+func encodeBSONValue(v any) (bsontype.Type, []byte, error) {
+	if v == nil {
+		return bsontype.Null, nil, nil
+	}
+	if m, ok := v.(bsonDocumentMarshaler); ok {
+		data, err := m.MarshalBSON()
+		if err != nil {
+			return bsontype.Null, nil, err
+		}
+		return bsontype.EmbeddedDocument, data, nil
+	}
+
+	switch val := v.(type) {
+	case string:
+		return bsontype.String, encodeBSONString(val), nil
+	case bool:
+		if val {
+			return bsontype.Boolean, []byte{0x01}, nil
+		}
+		return bsontype.Boolean, []byte{0x00}, nil
+	case int:
+		return bsontype.Int64, encodeBSONInt64(int64(val)), nil
+	case int32:
+		return bsontype.Int32, encodeBSONInt32(val), nil
+	case int64:
+		return bsontype.Int64, encodeBSONInt64(val), nil
+	case float32:
+		return bsontype.Double, encodeBSONDouble(float64(val)), nil
+	case float64:
+		return bsontype.Double, encodeBSONDouble(val), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		return encodeBSONArray(rv)
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return bsontype.Null, nil, nil
+		}
+		return encodeBSONValue(rv.Elem().Interface())
+	default:
+		return bsontype.Null, nil, fmt.Errorf("bson: unsupported value type %T", v)
+	}
+}
+
+// encodeBSONArray encodes a slice or array as a BSON array, whose elements
+// are a document keyed by stringified index.
+func encodeBSONArray(rv reflect.Value) (bsontype.Type, []byte, error) {
+	var buf bytes.Buffer
+	buf.Write(make([]byte, 4))
+	for i := 0; i < rv.Len(); i++ {
+		elemType, data, err := encodeBSONValue(rv.Index(i).Interface())
+		if err != nil {
+			return bsontype.Null, nil, err
+		}
+		buf.WriteByte(byte(elemType))
+		buf.WriteString(strconv.Itoa(i))
+		buf.WriteByte(0x00)
+		buf.Write(data)
+	}
+	buf.WriteByte(0x00)
+
+	out := buf.Bytes()
+	binary.LittleEndian.PutUint32(out[0:4], uint32(len(out)))
+	return bsontype.Array, out, nil
+}
+
+func encodeBSONString(s string) []byte {
+	b := make([]byte, 4+len(s)+1)
+	binary.LittleEndian.PutUint32(b[0:4], uint32(len(s)+1))
+	copy(b[4:], s)
+	return b
+}
+
+func encodeBSONInt32(v int32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, uint32(v))
+	return b
+}
+
+func encodeBSONInt64(v int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(v))
+	return b
+}
+
+func encodeBSONDouble(v float64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, math.Float64bits(v))
+	return b
+}
+
+// convertBSONValue adapts a decoded value (always one of the concrete Go
+// types produced by decodeBSONValue, e.g. int64 for bsontype.Int64) to
+// target, so maps with a concrete V (orderedMap[string, int], etc.) round-
+// trip without a bare, panic-prone type assertion. Nested documents always
+// decode as *orderedMap[string, any] regardless of target, since
+// decodeBSONValue has no way to know the concrete nested type up front; when
+// target is itself a concrete *orderedMap[K2, V2], this re-decodes into a
+// properly constructed instance of it instead of failing the conversion.
+func convertBSONValue(value any, target reflect.Type) (any, error) {
+	if value == nil {
+		return reflect.Zero(target).Interface(), nil
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Type().AssignableTo(target) {
+		return value, nil
+	}
+	if rv.Type().ConvertibleTo(target) {
+		return rv.Convert(target).Interface(), nil
+	}
+	if doc, ok := value.(bsonDocumentMarshaler); ok {
+		if ctor, ok := reflect.Zero(target).Interface().(nestedMapConstructor); ok {
+			raw, err := doc.MarshalBSON()
+			if err != nil {
+				return nil, err
+			}
+			fresh := ctor.newEmptyLike()
+			if err := fresh.(bsonDocumentUnmarshaler).UnmarshalBSON(raw); err != nil {
+				return nil, err
+			}
+			return fresh, nil
+		}
+	}
+	return nil, fmt.Errorf("bson: cannot convert %s into %s", rv.Type(), target)
+}
+
+// decodeBSONValue reads one BSON element value of the given type from data,
+// returning the decoded value and the number of bytes it consumed.
+func decodeBSONValue(t bsontype.Type, data []byte) (any, int, error) {
+	switch t {
+	case bsontype.Double:
+		return math.Float64frombits(binary.LittleEndian.Uint64(data[0:8])), 8, nil
+	case bsontype.String:
+		strLen := int(binary.LittleEndian.Uint32(data[0:4]))
+		return string(data[4 : 4+strLen-1]), 4 + strLen, nil
+	case bsontype.EmbeddedDocument:
+		docLen := int(binary.LittleEndian.Uint32(data[0:4]))
+		nested := New[string, any]()
+		if err := nested.UnmarshalBSON(data[0:docLen]); err != nil {
+			return nil, 0, err
+		}
+		return nested, docLen, nil
+	case bsontype.Array:
+		docLen := int(binary.LittleEndian.Uint32(data[0:4]))
+		nested := New[string, any]()
+		if err := nested.UnmarshalBSON(data[0:docLen]); err != nil {
+			return nil, 0, err
+		}
+		arr := nested.Values()
+		return arr, docLen, nil
+	case bsontype.Boolean:
+		return data[0] != 0x00, 1, nil
+	case bsontype.Null:
+		return nil, 0, nil
+	case bsontype.Int32:
+		return int32(binary.LittleEndian.Uint32(data[0:4])), 4, nil
+	case bsontype.Int64:
+		return int64(binary.LittleEndian.Uint64(data[0:8])), 8, nil
+	default:
+		return nil, 0, fmt.Errorf("bson: unsupported element type %v", t)
+	}
+}
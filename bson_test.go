@@ -0,0 +1,86 @@
+package orderedmap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedMapBSON(t *testing.T) {
+	t.Run("round-trip primitives", func(t *testing.T) {
+		om := New[string, any]()
+		om.Set("b", "two")
+		om.Set("a", int32(1))
+		om.Set("c", true)
+
+		data, err := om.MarshalBSON()
+		assert.Nil(t, err)
+
+		out := New[string, any]()
+		err = out.UnmarshalBSON(data)
+		assert.Nil(t, err)
+
+		assert.Equal(t, []string{"b", "a", "c"}, out.Keys())
+		assert.Equal(t, "two", out.GetOrDefault("b"))
+		assert.Equal(t, int32(1), out.GetOrDefault("a"))
+		assert.Equal(t, true, out.GetOrDefault("c"))
+	})
+
+	t.Run("round-trip nested map", func(t *testing.T) {
+		inner := New[string, any]()
+		inner.Set("x", int32(1))
+		inner.Set("y", int32(2))
+
+		outer := New[string, any]()
+		outer.Set("inner", inner)
+
+		data, err := outer.MarshalBSON()
+		assert.Nil(t, err)
+
+		out := New[string, any]()
+		err = out.UnmarshalBSON(data)
+		assert.Nil(t, err)
+
+		nested, ok := out.GetOrDefault("inner").(*orderedMap[string, any])
+		assert.True(t, ok)
+		assert.Equal(t, []string{"x", "y"}, nested.Keys())
+	})
+
+	t.Run("round-trip nested map with concrete value type", func(t *testing.T) {
+		inner := New[string, int]()
+		inner.Set("x", 1)
+		inner.Set("y", 2)
+
+		outer := New[string, *orderedMap[string, int]]()
+		outer.Set("inner", inner)
+
+		data, err := outer.MarshalBSON()
+		assert.Nil(t, err)
+
+		out := New[string, *orderedMap[string, int]]()
+		err = out.UnmarshalBSON(data)
+		assert.Nil(t, err)
+
+		nested := out.GetOrDefault("inner")
+		assert.Equal(t, []string{"x", "y"}, nested.Keys())
+		assert.Equal(t, 1, nested.GetOrDefault("x"))
+		assert.Equal(t, 2, nested.GetOrDefault("y"))
+	})
+
+	t.Run("round-trip with concrete value type", func(t *testing.T) {
+		om := New[string, int]()
+		om.Set("a", 5)
+		om.Set("b", 6)
+
+		data, err := om.MarshalBSON()
+		assert.Nil(t, err)
+
+		out := New[string, int]()
+		err = out.UnmarshalBSON(data)
+		assert.Nil(t, err)
+
+		assert.Equal(t, []string{"a", "b"}, out.Keys())
+		assert.Equal(t, 5, out.GetOrDefault("a"))
+		assert.Equal(t, 6, out.GetOrDefault("b"))
+	})
+}
@@ -0,0 +1,299 @@
+package orderedmap
+
+import (
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// ConcurrentOrderedMap wraps an orderedMap with a sync.RWMutex, guarding
+// every mutating/reading method. The plain orderedMap is unsafe under
+// concurrent Set/Delete because of pool reuse and linked-list splicing; use
+// this type when the map is shared across goroutines.
+type ConcurrentOrderedMap[K comparable, V any] struct {
+	mu sync.RWMutex
+	om *orderedMap[K, V]
+}
+
+// NewConcurrent builds a ConcurrentOrderedMap, forwarding opts to New.
+func NewConcurrent[K comparable, V any](opts ...Option[K, V]) *ConcurrentOrderedMap[K, V] {
+	return &ConcurrentOrderedMap[K, V]{om: New[K, V](opts...)}
+}
+
+func (c *ConcurrentOrderedMap[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.om.Set(key, value)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) Get(key K) (V, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.om.Get(key)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) GetOrDefault(key K) V {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.om.GetOrDefault(key)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.om.Delete(key)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) Pop(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.om.Pop(key)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.om.Clear()
+}
+
+func (c *ConcurrentOrderedMap[K, V]) Keys() []K {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.om.Keys()
+}
+
+func (c *ConcurrentOrderedMap[K, V]) Values() []V {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.om.Values()
+}
+
+func (c *ConcurrentOrderedMap[K, V]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.om.Len()
+}
+
+func (c *ConcurrentOrderedMap[K, V]) IsEmpty() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.om.IsEmpty()
+}
+
+func (c *ConcurrentOrderedMap[K, V]) ContainsKey(key K) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.om.ContainsKey(key)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) ContainsValue(value V, equal func(a, b V) bool) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.om.ContainsValue(value, equal)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) ContainsValueReflect(value V) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.om.ContainsValueReflect(value)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) IndexOf(key K) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.om.IndexOf(key)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) Reverse() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.om.Reverse()
+}
+
+func (c *ConcurrentOrderedMap[K, V]) SortAsc() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.om.SortAsc()
+}
+
+func (c *ConcurrentOrderedMap[K, V]) SortDesc() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.om.SortDesc()
+}
+
+func (c *ConcurrentOrderedMap[K, V]) InsertAt(key K, value V, pos int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.om.InsertAt(key, value, pos)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) MoveToFront(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.om.MoveToFront(key)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) MoveToBack(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.om.MoveToBack(key)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) MoveBefore(key, mark K) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.om.MoveBefore(key, mark)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) MoveAfter(key, mark K) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.om.MoveAfter(key, mark)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) GetAt(index int) (K, V, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.om.GetAt(index)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) DeleteAt(index int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.om.DeleteAt(index)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) AddPairs(pairs ...Pair[K, V]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.om.AddPairs(pairs...)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) Clone() *ConcurrentOrderedMap[K, V] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return &ConcurrentOrderedMap[K, V]{om: c.om.Clone()}
+}
+
+// Merge copies other's pairs into c, updating existing keys in place without
+// changing their position, same as orderedMap.Merge.
+func (c *ConcurrentOrderedMap[K, V]) Merge(other *ConcurrentOrderedMap[K, V]) {
+	pairs := other.snapshot()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, p := range pairs {
+		c.om.Set(p.Key, p.Value)
+	}
+}
+
+func (c *ConcurrentOrderedMap[K, V]) String() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.om.String()
+}
+
+func (c *ConcurrentOrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.om.MarshalJSON()
+}
+
+func (c *ConcurrentOrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.om.UnmarshalJSON(data)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) MarshalBSON() ([]byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.om.MarshalBSON()
+}
+
+func (c *ConcurrentOrderedMap[K, V]) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.om.MarshalBSONValue()
+}
+
+func (c *ConcurrentOrderedMap[K, V]) UnmarshalBSON(data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.om.UnmarshalBSON(data)
+}
+
+func (c *ConcurrentOrderedMap[K, V]) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.om.UnmarshalBSONValue(t, data)
+}
+
+// snapshot copies the map's pairs under the read lock so callers can yield
+// them without holding it.
+func (c *ConcurrentOrderedMap[K, V]) snapshot() []Pair[K, V] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	pairs := make([]Pair[K, V], 0, c.om.Len())
+	c.om.ForEach(func(k K, v V) {
+		pairs = append(pairs, Pair[K, V]{Key: k, Value: v})
+	})
+	return pairs
+}
+
+// ForEach yields a consistent snapshot of the map taken under the read lock,
+// without holding it while f runs.
+func (c *ConcurrentOrderedMap[K, V]) ForEach(f func(K, V)) {
+	for _, p := range c.snapshot() {
+		f(p.Key, p.Value)
+	}
+}
+
+// ForEachReverse is ForEach in reverse insertion order.
+func (c *ConcurrentOrderedMap[K, V]) ForEachReverse(f func(K, V)) {
+	pairs := c.snapshot()
+	for i := len(pairs) - 1; i >= 0; i-- {
+		f(pairs[i].Key, pairs[i].Value)
+	}
+}
+
+// Iter returns a push-style iterator over a snapshot taken under the read
+// lock, so ranging over it never holds the lock.
+func (c *ConcurrentOrderedMap[K, V]) Iter() func(yield func(K, V) bool) {
+	pairs := c.snapshot()
+	return func(yield func(K, V) bool) {
+		for _, p := range pairs {
+			if !yield(p.Key, p.Value) {
+				return
+			}
+		}
+	}
+}
+
+// IterReverse is Iter in reverse insertion order.
+func (c *ConcurrentOrderedMap[K, V]) IterReverse() func(yield func(K, V) bool) {
+	pairs := c.snapshot()
+	return func(yield func(K, V) bool) {
+		for i := len(pairs) - 1; i >= 0; i-- {
+			if !yield(pairs[i].Key, pairs[i].Value) {
+				return
+			}
+		}
+	}
+}
+
+// OrderedMapView is an exported handle on the package's (intentionally
+// hidden) orderedMap type, used only to give Atomic's callback a type
+// external packages can actually name.
+type OrderedMapView[K comparable, V any] struct {
+	*orderedMap[K, V]
+}
+
+// Atomic grants exclusive access to the underlying orderedMap for the
+// duration of f, letting callers compose multiple operations into one
+// transaction without intermediate locking.
+func (c *ConcurrentOrderedMap[K, V]) Atomic(f func(view *OrderedMapView[K, V])) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	f(&OrderedMapView[K, V]{c.om})
+}
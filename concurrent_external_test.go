@@ -0,0 +1,21 @@
+package orderedmap_test
+
+import (
+	"testing"
+
+	"github.com/emretanriverdi/orderedmap"
+	"github.com/stretchr/testify/assert"
+)
+
+// Atomic's callback parameter must be a type an external package can spell
+// out in a func literal; this exercises exactly that from outside the
+// package.
+func TestConcurrentOrderedMap_AtomicFromExternalPackage(t *testing.T) {
+	cm := orderedmap.NewConcurrent[string, int]()
+	cm.Atomic(func(view *orderedmap.OrderedMapView[string, int]) {
+		view.Set("a", 1)
+		view.Set("b", 2)
+	})
+
+	assert.Equal(t, []string{"a", "b"}, cm.Keys())
+}
@@ -0,0 +1,51 @@
+package orderedmap
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentOrderedMap(t *testing.T) {
+	t.Run("concurrent Set/Delete", func(t *testing.T) {
+		cm := NewConcurrent[string, int]()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				cm.Set("k", i)
+				cm.Delete("k")
+			}(i)
+		}
+		wg.Wait()
+
+		assert.True(t, cm.IsEmpty())
+	})
+
+	t.Run("ForEach snapshot", func(t *testing.T) {
+		cm := NewConcurrent[string, int]()
+		cm.Set("a", 1)
+		cm.Set("b", 2)
+		cm.Set("c", 3)
+
+		var keys []string
+		cm.ForEach(func(k string, v int) {
+			keys = append(keys, k)
+		})
+
+		assert.Equal(t, []string{"a", "b", "c"}, keys)
+	})
+
+	t.Run("Atomic transaction", func(t *testing.T) {
+		cm := NewConcurrent[string, int]()
+		cm.Atomic(func(view *OrderedMapView[string, int]) {
+			view.Set("a", 1)
+			view.Set("b", 2)
+		})
+
+		assert.Equal(t, []string{"a", "b"}, cm.Keys())
+	})
+}
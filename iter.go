@@ -0,0 +1,94 @@
+package orderedmap
+
+import "iter"
+
+// Iter returns a push-style iterator over the map in insertion order, so
+// callers can write `for k, v := range om.Iter()`.
+func (om *orderedMap[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := om.head; n != nil; n = n.next {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// IterReverse is Iter in reverse insertion order.
+func (om *orderedMap[K, V]) IterReverse() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := om.tail; n != nil; n = n.prev {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns a view of seq containing only the pairs for which pred
+// returns true.
+func Filter[K comparable, V any](seq iter.Seq2[K, V], pred func(K, V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if pred(k, v) && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns a view of seq with each pair transformed by f.
+func Map[K comparable, V any, K2 comparable, V2 any](seq iter.Seq2[K, V], f func(K, V) (K2, V2)) iter.Seq2[K2, V2] {
+	return func(yield func(K2, V2) bool) {
+		for k, v := range seq {
+			if !yield(f(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// Take returns a view of the first n pairs of seq.
+func Take[K comparable, V any](seq iter.Seq2[K, V], n int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for k, v := range seq {
+			if !yield(k, v) {
+				return
+			}
+			i++
+			if i >= n {
+				return
+			}
+		}
+	}
+}
+
+// Skip returns a view of seq with the first n pairs omitted.
+func Skip[K comparable, V any](seq iter.Seq2[K, V], n int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		i := 0
+		for k, v := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Collect materializes seq back into a new orderedMap, in the order seq
+// yields its pairs.
+func Collect[K comparable, V any](seq iter.Seq2[K, V]) *orderedMap[K, V] {
+	om := New[K, V]()
+	for k, v := range seq {
+		om.Set(k, v)
+	}
+	return om
+}
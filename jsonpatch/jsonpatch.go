@@ -0,0 +1,122 @@
+// Package jsonpatch applies RFC 6902 JSON Patch documents to orderedmap
+// structures, building on jsonpointer to preserve insertion order.
+package jsonpatch
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/emretanriverdi/orderedmap"
+	"github.com/emretanriverdi/orderedmap/jsonpointer"
+)
+
+// Operation is a single RFC 6902 patch operation.
+type Operation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	From  string `json:"from,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+// Apply runs ops against om in order, mutating it in place.
+func Apply(om *orderedmap.StringAnyOrderedMap, ops []Operation) error {
+	for _, op := range ops {
+		if err := applyOne(om, op); err != nil {
+			return fmt.Errorf("jsonpatch: %s %s: %w", op.Op, op.Path, err)
+		}
+	}
+	return nil
+}
+
+func applyOne(om *orderedmap.StringAnyOrderedMap, op Operation) error {
+	switch op.Op {
+	case "add":
+		return jsonpointer.Insert(om, op.Path, op.Value)
+	case "remove":
+		return jsonpointer.Delete(om, op.Path)
+	case "replace":
+		return jsonpointer.Set(om, op.Path, op.Value)
+	case "move":
+		return opMove(om, op.From, op.Path)
+	case "copy":
+		value, err := jsonpointer.Get(om, op.From)
+		if err != nil {
+			return err
+		}
+		return jsonpointer.Insert(om, op.Path, deepCopyValue(value))
+	case "test":
+		value, err := jsonpointer.Get(om, op.Path)
+		if err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(value, op.Value) {
+			return fmt.Errorf("test failed: value at %q does not match", op.Path)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported operation %q", op.Op)
+	}
+}
+
+// opMove moves the value at from to path. When both reference keys of the
+// same object and the destination key already exists, it splices the
+// existing node into place via MoveBefore instead of deleting and
+// re-inserting, matching the library's O(1)-splice move primitives.
+func opMove(om *orderedmap.StringAnyOrderedMap, from, path string) error {
+	fromParent, fromKey, err := jsonpointer.SplitLastToken(from)
+	if err != nil {
+		return err
+	}
+	toParent, toKey, err := jsonpointer.SplitLastToken(path)
+	if err != nil {
+		return err
+	}
+
+	if fromParent == toParent && fromKey != toKey {
+		parent, err := parentContainer(om, fromParent)
+		if err == nil {
+			if m, ok := parent.(*orderedmap.StringAnyOrderedMap); ok && m.ContainsKey(toKey) {
+				return m.MoveBefore(fromKey, toKey)
+			}
+		}
+	}
+
+	value, err := jsonpointer.Get(om, from)
+	if err != nil {
+		return err
+	}
+	if err := jsonpointer.Delete(om, from); err != nil {
+		return err
+	}
+	return jsonpointer.Insert(om, path, value)
+}
+
+// deepCopyValue recursively copies nested orderedmap and slice containers so
+// the "copy" operation produces a value fully independent of the source, per
+// RFC 6902 ("the target location MUST NOT be affected by subsequent changes
+// to the source location").
+func deepCopyValue(value any) any {
+	switch v := value.(type) {
+	case *orderedmap.StringAnyOrderedMap:
+		cloned := v.Clone()
+		v.ForEach(func(key string, child any) {
+			cloned.Set(key, deepCopyValue(child))
+		})
+		return cloned
+	case []any:
+		cloned := make([]any, len(v))
+		for i, child := range v {
+			cloned[i] = deepCopyValue(child)
+		}
+		return cloned
+	default:
+		return value
+	}
+}
+
+func parentContainer(om *orderedmap.StringAnyOrderedMap, parentPointer string) (any, error) {
+	if parentPointer == "" {
+		return om, nil
+	}
+	return jsonpointer.Get(om, parentPointer)
+}
@@ -0,0 +1,109 @@
+package jsonpatch_test
+
+import (
+	"testing"
+
+	"github.com/emretanriverdi/orderedmap"
+	"github.com/emretanriverdi/orderedmap/jsonpatch"
+	"github.com/emretanriverdi/orderedmap/jsonpointer"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildDoc() *orderedmap.StringAnyOrderedMap {
+	om := orderedmap.New[string, any]()
+	om.Set("a", 1)
+	om.Set("b", 2)
+	om.Set("list", []any{"x", "y"})
+	return om
+}
+
+func TestApply(t *testing.T) {
+	t.Run("add appends new key", func(t *testing.T) {
+		om := buildDoc()
+		err := jsonpatch.Apply(om, []jsonpatch.Operation{{Op: "add", Path: "/c", Value: 3}})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "b", "list", "c"}, om.Keys())
+	})
+
+	t.Run("remove deletes key", func(t *testing.T) {
+		om := buildDoc()
+		err := jsonpatch.Apply(om, []jsonpatch.Operation{{Op: "remove", Path: "/a"}})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"b", "list"}, om.Keys())
+	})
+
+	t.Run("replace updates value in place", func(t *testing.T) {
+		om := buildDoc()
+		err := jsonpatch.Apply(om, []jsonpatch.Operation{{Op: "replace", Path: "/a", Value: 100}})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "b", "list"}, om.Keys())
+		assert.Equal(t, 100, om.GetOrDefault("a"))
+	})
+
+	t.Run("move repositions within the same object", func(t *testing.T) {
+		om := buildDoc()
+		err := jsonpatch.Apply(om, []jsonpatch.Operation{{Op: "move", From: "/b", Path: "/a"}})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"b", "a", "list"}, om.Keys())
+	})
+
+	t.Run("move splices a source key containing escaped characters", func(t *testing.T) {
+		om := orderedmap.New[string, any]()
+		om.Set("c", 2)
+		om.Set("a/b", 1)
+
+		err := jsonpatch.Apply(om, []jsonpatch.Operation{{Op: "move", From: "/a~1b", Path: "/c"}})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a/b", "c"}, om.Keys())
+	})
+
+	t.Run("copy duplicates value", func(t *testing.T) {
+		om := buildDoc()
+		err := jsonpatch.Apply(om, []jsonpatch.Operation{{Op: "copy", From: "/a", Path: "/c"}})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, om.GetOrDefault("c"))
+	})
+
+	t.Run("copy deep-copies nested containers", func(t *testing.T) {
+		om := buildDoc()
+		err := jsonpatch.Apply(om, []jsonpatch.Operation{{Op: "copy", From: "/list", Path: "/listCopy"}})
+		assert.Nil(t, err)
+
+		err = jsonpatch.Apply(om, []jsonpatch.Operation{{Op: "replace", Path: "/listCopy/0", Value: "changed"}})
+		assert.Nil(t, err)
+
+		original, err := jsonpointer.Get(om, "/list")
+		assert.Nil(t, err)
+		assert.Equal(t, []any{"x", "y"}, original)
+	})
+
+	t.Run("move at the root returns an error instead of panicking", func(t *testing.T) {
+		om := buildDoc()
+		err := jsonpatch.Apply(om, []jsonpatch.Operation{{Op: "move", From: "", Path: "/c"}})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("copy at the root returns an error instead of panicking", func(t *testing.T) {
+		om := buildDoc()
+		err := jsonpatch.Apply(om, []jsonpatch.Operation{{Op: "copy", From: "/a", Path: ""}})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("test passes and fails", func(t *testing.T) {
+		om := buildDoc()
+		err := jsonpatch.Apply(om, []jsonpatch.Operation{{Op: "test", Path: "/a", Value: 1}})
+		assert.Nil(t, err)
+
+		err = jsonpatch.Apply(om, []jsonpatch.Operation{{Op: "test", Path: "/a", Value: 2}})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("add on array inserts at index", func(t *testing.T) {
+		om := buildDoc()
+		err := jsonpatch.Apply(om, []jsonpatch.Operation{{Op: "add", Path: "/list/1", Value: "w"}})
+		assert.Nil(t, err)
+		v, err := jsonpointer.Get(om, "/list")
+		assert.Nil(t, err)
+		assert.Equal(t, []any{"x", "w", "y"}, v)
+	})
+}
@@ -0,0 +1,251 @@
+// Package jsonpointer resolves RFC 6901 JSON Pointers against nested
+// orderedmap structures, preserving insertion order at every depth.
+package jsonpointer
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/emretanriverdi/orderedmap"
+)
+
+var errEmptyPointer = errors.New("jsonpointer: pointer does not reference a location that can be modified")
+
+// resolved is the outcome of walking a pointer's tokens: the container the
+// tokens led to, and a writeBack that replaces that container within its own
+// parent (nil when the container is the root map itself).
+type resolved struct {
+	container any
+	writeBack func(any) error
+}
+
+// Get resolves pointer against om and returns the value it references. An
+// empty pointer returns om itself.
+func Get(om *orderedmap.StringAnyOrderedMap, pointer string) (any, error) {
+	tokens, err := parseTokens(pointer)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resolveContainer(om, tokens)
+	if err != nil {
+		return nil, err
+	}
+	return res.container, nil
+}
+
+// Set replaces the value at pointer in place. The location must already
+// exist; Set never grows an array or appends a new object key at the end -
+// use Insert for that.
+func Set(om *orderedmap.StringAnyOrderedMap, pointer string, value any) error {
+	parentTokens, lastTok, err := splitLastToken(pointer)
+	if err != nil {
+		return err
+	}
+	res, err := resolveContainer(om, parentTokens)
+	if err != nil {
+		return err
+	}
+	switch c := res.container.(type) {
+	case *orderedmap.StringAnyOrderedMap:
+		if !c.ContainsKey(lastTok) {
+			return fmt.Errorf("jsonpointer: key %q not found", lastTok)
+		}
+		c.Set(lastTok, value)
+		return nil
+	case []any:
+		idx, err := parseArrayIndex(lastTok, len(c))
+		if err != nil {
+			return err
+		}
+		c[idx] = value
+		return nil
+	default:
+		return fmt.Errorf("jsonpointer: cannot set into %T", res.container)
+	}
+}
+
+// Insert adds value at pointer: on an object it sets the key (appending it
+// at the end if new), on an array it grows the array, inserting at the
+// given index or, for the "-" token, appending.
+func Insert(om *orderedmap.StringAnyOrderedMap, pointer string, value any) error {
+	parentTokens, lastTok, err := splitLastToken(pointer)
+	if err != nil {
+		return err
+	}
+	res, err := resolveContainer(om, parentTokens)
+	if err != nil {
+		return err
+	}
+	switch c := res.container.(type) {
+	case *orderedmap.StringAnyOrderedMap:
+		c.Set(lastTok, value)
+		return nil
+	case []any:
+		idx, err := parseInsertIndex(lastTok, len(c))
+		if err != nil {
+			return err
+		}
+		newArr := make([]any, 0, len(c)+1)
+		newArr = append(newArr, c[:idx]...)
+		newArr = append(newArr, value)
+		newArr = append(newArr, c[idx:]...)
+		if res.writeBack == nil {
+			return errEmptyPointer
+		}
+		return res.writeBack(newArr)
+	default:
+		return fmt.Errorf("jsonpointer: cannot insert into %T", res.container)
+	}
+}
+
+// Delete removes the value at pointer, resizing the containing array if
+// necessary.
+func Delete(om *orderedmap.StringAnyOrderedMap, pointer string) error {
+	parentTokens, lastTok, err := splitLastToken(pointer)
+	if err != nil {
+		return err
+	}
+	res, err := resolveContainer(om, parentTokens)
+	if err != nil {
+		return err
+	}
+	switch c := res.container.(type) {
+	case *orderedmap.StringAnyOrderedMap:
+		c.Delete(lastTok)
+		return nil
+	case []any:
+		idx, err := parseArrayIndex(lastTok, len(c))
+		if err != nil {
+			return err
+		}
+		newArr := make([]any, 0, len(c)-1)
+		newArr = append(newArr, c[:idx]...)
+		newArr = append(newArr, c[idx+1:]...)
+		if res.writeBack == nil {
+			return errEmptyPointer
+		}
+		return res.writeBack(newArr)
+	default:
+		return fmt.Errorf("jsonpointer: cannot delete from %T", res.container)
+	}
+}
+
+// resolveContainer walks tokens from om, returning the container they lead
+// to along with a writeBack that replaces that container within its parent.
+func resolveContainer(om *orderedmap.StringAnyOrderedMap, tokens []string) (resolved, error) {
+	var cur any = om
+	var writeBack func(any) error
+
+	for _, raw := range tokens {
+		tok := unescapeToken(raw)
+		switch c := cur.(type) {
+		case *orderedmap.StringAnyOrderedMap:
+			child, err := c.Get(tok)
+			if err != nil {
+				return resolved{}, fmt.Errorf("jsonpointer: key %q not found", tok)
+			}
+			parent, key := c, tok
+			writeBack = func(v any) error {
+				parent.Set(key, v)
+				return nil
+			}
+			cur = child
+		case []any:
+			idx, err := parseArrayIndex(tok, len(c))
+			if err != nil {
+				return resolved{}, err
+			}
+			arr, i := c, idx
+			writeBack = func(v any) error {
+				arr[i] = v
+				return nil
+			}
+			cur = arr[idx]
+		default:
+			return resolved{}, fmt.Errorf("jsonpointer: cannot traverse into %T", cur)
+		}
+	}
+	return resolved{container: cur, writeBack: writeBack}, nil
+}
+
+// splitLastToken parses pointer and splits it into the tokens addressing
+// its parent container and the final (unescaped) token.
+func splitLastToken(pointer string) (parentTokens []string, lastTok string, err error) {
+	tokens, err := parseTokens(pointer)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(tokens) == 0 {
+		return nil, "", errEmptyPointer
+	}
+	return tokens[:len(tokens)-1], unescapeToken(tokens[len(tokens)-1]), nil
+}
+
+// SplitLastToken is the string-pointer counterpart to this package's
+// internal, token-slice based splitLastToken: it splits pointer into the
+// pointer addressing its parent container and its final, unescaped token.
+// It's exported so other pointer-aware packages (e.g. jsonpatch) can reuse
+// the same guarded splitting logic - including rejecting the root pointer
+// ("", which has no parent to split off) - rather than reimplementing it.
+func SplitLastToken(pointer string) (parent, last string, err error) {
+	parentTokens, lastTok, err := splitLastToken(pointer)
+	if err != nil {
+		return "", "", err
+	}
+	if len(parentTokens) == 0 {
+		return "", lastTok, nil
+	}
+	return "/" + strings.Join(parentTokens, "/"), lastTok, nil
+}
+
+func parseTokens(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("jsonpointer: pointer must start with '/': %q", pointer)
+	}
+	return strings.Split(pointer[1:], "/"), nil
+}
+
+func parseArrayIndex(tok string, length int) (int, error) {
+	idx, err := strconv.Atoi(tok)
+	if err != nil {
+		return 0, fmt.Errorf("jsonpointer: invalid array index %q", tok)
+	}
+	if idx < 0 || idx >= length {
+		return 0, fmt.Errorf("jsonpointer: array index %d out of range [0,%d)", idx, length)
+	}
+	return idx, nil
+}
+
+// parseInsertIndex is parseArrayIndex but also allows "-" (append) and an
+// index equal to length (insert at the end).
+func parseInsertIndex(tok string, length int) (int, error) {
+	if tok == "-" {
+		return length, nil
+	}
+	idx, err := strconv.Atoi(tok)
+	if err != nil {
+		return 0, fmt.Errorf("jsonpointer: invalid array index %q", tok)
+	}
+	if idx < 0 || idx > length {
+		return 0, fmt.Errorf("jsonpointer: array index %d out of range [0,%d]", idx, length)
+	}
+	return idx, nil
+}
+
+// UnescapeToken decodes the ~1 and ~0 escapes defined by RFC 6901. It's
+// exported so other pointer-aware packages (e.g. jsonpatch) can unescape a
+// raw token the same way this package does internally.
+func UnescapeToken(tok string) string {
+	tok = strings.ReplaceAll(tok, "~1", "/")
+	tok = strings.ReplaceAll(tok, "~0", "~")
+	return tok
+}
+
+func unescapeToken(tok string) string {
+	return UnescapeToken(tok)
+}
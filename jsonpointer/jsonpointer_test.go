@@ -0,0 +1,94 @@
+package jsonpointer_test
+
+import (
+	"testing"
+
+	"github.com/emretanriverdi/orderedmap"
+	"github.com/emretanriverdi/orderedmap/jsonpointer"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildDoc() *orderedmap.StringAnyOrderedMap {
+	inner := orderedmap.New[string, any]()
+	inner.Set("b", 2)
+	om := orderedmap.New[string, any]()
+	om.Set("a", inner)
+	om.Set("list", []any{"x", "y", "z"})
+	return om
+}
+
+func TestJSONPointer(t *testing.T) {
+	t.Run("Get nested key", func(t *testing.T) {
+		om := buildDoc()
+		v, err := jsonpointer.Get(om, "/a/b")
+		assert.Nil(t, err)
+		assert.Equal(t, 2, v)
+	})
+
+	t.Run("Get array index", func(t *testing.T) {
+		om := buildDoc()
+		v, err := jsonpointer.Get(om, "/list/1")
+		assert.Nil(t, err)
+		assert.Equal(t, "y", v)
+	})
+
+	t.Run("Get missing key", func(t *testing.T) {
+		om := buildDoc()
+		_, err := jsonpointer.Get(om, "/a/missing")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Set replaces in place", func(t *testing.T) {
+		om := buildDoc()
+		err := jsonpointer.Set(om, "/a/b", 99)
+		assert.Nil(t, err)
+		v, _ := jsonpointer.Get(om, "/a/b")
+		assert.Equal(t, 99, v)
+	})
+
+	t.Run("Insert appends to array", func(t *testing.T) {
+		om := buildDoc()
+		err := jsonpointer.Insert(om, "/list/-", "w")
+		assert.Nil(t, err)
+		v, _ := jsonpointer.Get(om, "/list/3")
+		assert.Equal(t, "w", v)
+	})
+
+	t.Run("Insert appends new object key", func(t *testing.T) {
+		om := buildDoc()
+		err := jsonpointer.Insert(om, "/c", 3)
+		assert.Nil(t, err)
+		v, _ := jsonpointer.Get(om, "/c")
+		assert.Equal(t, 3, v)
+	})
+
+	t.Run("Delete object key", func(t *testing.T) {
+		om := buildDoc()
+		err := jsonpointer.Delete(om, "/a/b")
+		assert.Nil(t, err)
+		_, err = jsonpointer.Get(om, "/a/b")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Delete array element", func(t *testing.T) {
+		om := buildDoc()
+		err := jsonpointer.Delete(om, "/list/1")
+		assert.Nil(t, err)
+		v, _ := jsonpointer.Get(om, "/list")
+		assert.Equal(t, []any{"x", "z"}, v)
+	})
+
+	t.Run("escaped tokens", func(t *testing.T) {
+		om := orderedmap.New[string, any]()
+		om.Set("a/b", 1)
+		om.Set("c~d", 2)
+
+		v, err := jsonpointer.Get(om, "/a~1b")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, v)
+
+		v, err = jsonpointer.Get(om, "/c~0d")
+		assert.Nil(t, err)
+		assert.Equal(t, 2, v)
+	})
+}
@@ -12,6 +12,8 @@ import (
 
 var errKeyNotFound = errors.New("key not found")
 var errKeyMustBeStringForJson = errors.New("error in json: key must be string")
+var errIndexOutOfRange = errors.New("index out of range")
+var errKeyAlreadyExists = errors.New("key already exists")
 
 type node[K comparable, V any] struct {
 	key   K
@@ -29,14 +31,64 @@ type orderedMap[K comparable, V any] struct {
 	isKeyString bool // pre-calculate key's type to check if it's parseable (and save it to avoid multiple calculations)
 }
 
-func New[K comparable, V any]() *orderedMap[K, V] { // intentionally hidden
-	return NewWithCapacity[K, V](16) // pre-allocate
+// StringAnyOrderedMap is an exported alias of the package's (intentionally
+// hidden) orderedMap type, instantiated for string keys and `any` values -
+// the only instantiation the jsonpointer and jsonpatch subpackages need to
+// name in their own function signatures. It's a concrete (non-generic)
+// alias rather than `type StringAnyOrderedMap[K, V] = orderedMap[K, V]`
+// because generic type aliases require Go 1.24, a newer version than the
+// rest of this module relies on.
+type StringAnyOrderedMap = orderedMap[string, any]
+
+// Option configures an orderedMap at construction time. See WithCapacity and
+// WithInitialData.
+type Option[K comparable, V any] func(*orderedMap[K, V])
+
+// nestedMapConstructor is implemented by every *orderedMap[K, V], whatever K
+// and V are instantiated as, since newEmptyLike is defined once on the
+// generic type itself. UnmarshalJSON and UnmarshalBSON use it to build a
+// nested orderedMap value through New - with its pool and key-type cache
+// properly set up - instead of letting the decoder hand them a bare
+// zero-value pointer.
+type nestedMapConstructor interface {
+	newEmptyLike() any
+}
+
+func (om *orderedMap[K, V]) newEmptyLike() any {
+	return New[K, V]()
+}
+
+// WithCapacity pre-allocates the backing map for the given number of
+// entries. It rehashes into the new map rather than replacing it outright,
+// so it's safe to apply after WithInitialData has already populated entries.
+func WithCapacity[K comparable, V any](capacity int) Option[K, V] {
+	return func(om *orderedMap[K, V]) {
+		rehashed := make(map[K]*node[K, V], capacity)
+		for k, n := range om.values {
+			rehashed[k] = n
+		}
+		om.values = rehashed
+	}
+}
+
+// WithInitialData seeds the map with pairs, preserving their order.
+func WithInitialData[K comparable, V any](pairs ...Pair[K, V]) Option[K, V] {
+	return func(om *orderedMap[K, V]) {
+		om.AddPairs(pairs...)
+	}
+}
+
+// Pair is a key/value pair used for bulk insertion via WithInitialData and
+// AddPairs.
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
 }
 
-func NewWithCapacity[K comparable, V any](capacity int) *orderedMap[K, V] {
+func New[K comparable, V any](opts ...Option[K, V]) *orderedMap[K, V] { // intentionally hidden
 	var zero K
 	om := &orderedMap[K, V]{
-		values: make(map[K]*node[K, V], capacity),
+		values: make(map[K]*node[K, V], 16), // pre-allocate
 		pool: &sync.Pool{
 			New: func() interface{} {
 				return new(node[K, V])
@@ -44,9 +96,19 @@ func NewWithCapacity[K comparable, V any](capacity int) *orderedMap[K, V] {
 		},
 		isKeyString: isKeyString(zero),
 	}
+	for _, opt := range opts {
+		opt(om)
+	}
 	return om
 }
 
+// AddPairs inserts pairs in order, appending each to the back of the map.
+func (om *orderedMap[K, V]) AddPairs(pairs ...Pair[K, V]) {
+	for _, p := range pairs {
+		om.Set(p.Key, p.Value)
+	}
+}
+
 func (om *orderedMap[K, V]) Set(key K, value V) {
 	if existingNode, exists := om.values[key]; exists {
 		existingNode.value = value
@@ -191,6 +253,185 @@ func (om *orderedMap[K, V]) IndexOf(key K) int {
 	return -1
 }
 
+// InsertAt inserts key/value at the given position, shifting elements from
+// that position onward. Negative positions count from the end (-1 = last).
+func (om *orderedMap[K, V]) InsertAt(key K, value V, pos int) error {
+	if _, exists := om.values[key]; exists {
+		return errKeyAlreadyExists
+	}
+	if pos < 0 {
+		pos = om.size + pos + 1
+	}
+	if pos < 0 || pos > om.size {
+		return errIndexOutOfRange
+	}
+
+	n := om.pool.Get().(*node[K, V])
+	n.key = key
+	n.value = value
+	n.prev = nil
+	n.next = nil
+	om.values[key] = n
+
+	switch {
+	case om.size == 0:
+		om.head = n
+		om.tail = n
+	case pos == 0:
+		n.next = om.head
+		om.head.prev = n
+		om.head = n
+	case pos == om.size:
+		n.prev = om.tail
+		om.tail.next = n
+		om.tail = n
+	default:
+		cur := om.nodeAt(pos)
+		prev := cur.prev
+		n.prev = prev
+		n.next = cur
+		prev.next = n
+		cur.prev = n
+	}
+	om.size++
+	return nil
+}
+
+// MoveToFront moves key to the front of the order. No-op if key is missing.
+func (om *orderedMap[K, V]) MoveToFront(key K) {
+	n, exists := om.values[key]
+	if !exists || n == om.head {
+		return
+	}
+	om.unlink(n)
+	n.prev = nil
+	n.next = om.head
+	om.head.prev = n
+	om.head = n
+}
+
+// MoveToBack moves key to the back of the order. No-op if key is missing.
+func (om *orderedMap[K, V]) MoveToBack(key K) {
+	n, exists := om.values[key]
+	if !exists || n == om.tail {
+		return
+	}
+	om.unlink(n)
+	n.next = nil
+	n.prev = om.tail
+	om.tail.next = n
+	om.tail = n
+}
+
+// MoveBefore repositions key so it immediately precedes mark.
+func (om *orderedMap[K, V]) MoveBefore(key, mark K) error {
+	n, exists := om.values[key]
+	if !exists {
+		return errKeyNotFound
+	}
+	m, exists := om.values[mark]
+	if !exists {
+		return errKeyNotFound
+	}
+	if n == m {
+		return nil
+	}
+	om.unlink(n)
+	n.prev = m.prev
+	n.next = m
+	if m.prev != nil {
+		m.prev.next = n
+	} else {
+		om.head = n
+	}
+	m.prev = n
+	return nil
+}
+
+// MoveAfter repositions key so it immediately follows mark.
+func (om *orderedMap[K, V]) MoveAfter(key, mark K) error {
+	n, exists := om.values[key]
+	if !exists {
+		return errKeyNotFound
+	}
+	m, exists := om.values[mark]
+	if !exists {
+		return errKeyNotFound
+	}
+	if n == m {
+		return nil
+	}
+	om.unlink(n)
+	n.next = m.next
+	n.prev = m
+	if m.next != nil {
+		m.next.prev = n
+	} else {
+		om.tail = n
+	}
+	m.next = n
+	return nil
+}
+
+// GetAt returns the key/value at index, which may be negative to count from
+// the end (-1 = last).
+func (om *orderedMap[K, V]) GetAt(index int) (K, V, error) {
+	if index < 0 {
+		index = om.size + index
+	}
+	if index < 0 || index >= om.size {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, errIndexOutOfRange
+	}
+	n := om.nodeAt(index)
+	return n.key, n.value, nil
+}
+
+// DeleteAt removes the element at index, which may be negative to count from
+// the end (-1 = last).
+func (om *orderedMap[K, V]) DeleteAt(index int) error {
+	if index < 0 {
+		index = om.size + index
+	}
+	if index < 0 || index >= om.size {
+		return errIndexOutOfRange
+	}
+	n := om.nodeAt(index)
+	om.Delete(n.key)
+	return nil
+}
+
+// nodeAt returns the node at index, walking from whichever end is closer.
+func (om *orderedMap[K, V]) nodeAt(index int) *node[K, V] {
+	if index < om.size/2 {
+		n := om.head
+		for i := 0; i < index; i++ {
+			n = n.next
+		}
+		return n
+	}
+	n := om.tail
+	for i := om.size - 1; i > index; i-- {
+		n = n.prev
+	}
+	return n
+}
+
+// unlink splices n out of the list without touching the pool or the map.
+func (om *orderedMap[K, V]) unlink(n *node[K, V]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		om.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		om.tail = n.prev
+	}
+}
+
 func (om *orderedMap[K, V]) Pop(key K) (V, bool) {
 	if n, exists := om.values[key]; exists {
 		value := n.value
@@ -202,7 +443,7 @@ func (om *orderedMap[K, V]) Pop(key K) (V, bool) {
 }
 
 func (om *orderedMap[K, V]) Clone() *orderedMap[K, V] {
-	newMap := NewWithCapacity[K, V](om.size)
+	newMap := New[K, V](WithCapacity[K, V](om.size))
 	for n := om.head; n != nil; n = n.next {
 		newMap.Set(n.key, n.value)
 	}
@@ -304,6 +545,7 @@ func (om *orderedMap[K, V]) UnmarshalJSON(data []byte) error {
 		return fmt.Errorf("error reading opening token: %w", err)
 	}
 
+	decodeAsAny := isAnyType[V]()
 	for dec.More() {
 		token, err := dec.Token()
 		if err != nil {
@@ -321,13 +563,26 @@ func (om *orderedMap[K, V]) UnmarshalJSON(data []byte) error {
 			return fmt.Errorf("error in json: %w", err)
 		}
 
-		var value V // tricky force-casting by checking if value can be treated as orderedmap to use its own unmarshal
-		if _, isMap := any(value).(*orderedMap[string, any]); isMap {
-			nested := New[string, any]()
-			if err := json.Unmarshal(raw, nested); err != nil {
-				return fmt.Errorf("error unmarshaling nested map for key %s: %w", keyStr, err)
+		var value V
+		if decodeAsAny && startsWithObjectOrArray(raw) {
+			// V is `any`: decode nested objects/arrays through orderedMap
+			// itself so order survives at every depth, instead of letting
+			// json.Unmarshal fall back to map[string]interface{}.
+			decoded, err := decodeOrderedAny(raw)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling value for key %s: %w", keyStr, err)
+			}
+			value = any(decoded).(V)
+		} else if ctor, ok := any(value).(nestedMapConstructor); ok {
+			// V is itself a concrete *orderedMap[K2, V2]: construct it
+			// through New so its pool and key-type cache are set up before
+			// decoding into it, instead of letting json.Unmarshal allocate
+			// a bare zero-value pointer.
+			fresh := ctor.newEmptyLike()
+			if err := json.Unmarshal(raw, fresh); err != nil {
+				return fmt.Errorf("error unmarshaling value for key %s: %w", keyStr, err)
 			}
-			value = any(nested).(V)
+			value = fresh.(V)
 		} else {
 			if err := json.Unmarshal(raw, &value); err != nil {
 				return fmt.Errorf("error unmarshaling value for key %s: %w", keyStr, err)
@@ -342,6 +597,89 @@ func (om *orderedMap[K, V]) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// isAnyType reports whether V is the empty interface, i.e. `any`.
+func isAnyType[V any]() bool {
+	var v V
+	t := reflect.TypeOf(&v).Elem()
+	return t.Kind() == reflect.Interface && t.NumMethod() == 0
+}
+
+func startsWithObjectOrArray(raw json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 {
+		return false
+	}
+	return trimmed[0] == '{' || trimmed[0] == '['
+}
+
+// decodeOrderedAny decodes raw into a value suitable for storing in an `any`
+// slot: objects become *orderedMap[string, any], arrays of objects become
+// []*orderedMap[string, any], other arrays become []any, and everything else
+// is decoded normally. It recurses so order is preserved at every depth.
+func decodeOrderedAny(raw json.RawMessage) (any, error) {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 {
+		return nil, nil
+	}
+
+	switch trimmed[0] {
+	case '{':
+		nested := New[string, any]()
+		if err := json.Unmarshal(raw, nested); err != nil {
+			return nil, err
+		}
+		return nested, nil
+	case '[':
+		return decodeOrderedAnySlice(raw)
+	default:
+		var v any
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func decodeOrderedAnySlice(raw json.RawMessage) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("error reading opening token: %w", err)
+	}
+
+	var elems []any
+	for dec.More() {
+		var rawElem json.RawMessage
+		if err := dec.Decode(&rawElem); err != nil {
+			return nil, fmt.Errorf("error in json: %w", err)
+		}
+		elem, err := decodeOrderedAny(rawElem)
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, elem)
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("error reading closing token: %w", err)
+	}
+
+	if len(elems) > 0 {
+		maps := make([]*orderedMap[string, any], len(elems))
+		allMaps := true
+		for i, e := range elems {
+			m, ok := e.(*orderedMap[string, any])
+			if !ok {
+				allMaps = false
+				break
+			}
+			maps[i] = m
+		}
+		if allMaps {
+			return maps, nil
+		}
+	}
+	return elems, nil
+}
+
 func (om *orderedMap[K, V]) Len() int {
 	return om.size
 }
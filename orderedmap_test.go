@@ -348,4 +348,161 @@ func TestOrderedMap(t *testing.T) {
 		assert.Equal(t, 1, om.IndexOf("b"))
 		assert.Equal(t, -1, om.IndexOf("c"))
 	})
+
+	t.Run("InsertAt", func(t *testing.T) {
+		om := New[string, int]()
+		om.Set("a", 1)
+		om.Set("c", 3)
+
+		err := om.InsertAt("b", 2, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, om.Keys())
+
+		err = om.InsertAt("z", 26, -1)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "b", "c", "z"}, om.Keys())
+
+		err = om.InsertAt("front", 0, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"front", "a", "b", "c", "z"}, om.Keys())
+
+		err = om.InsertAt("a", 99, 0)
+		assert.NotNil(t, err)
+
+		err = om.InsertAt("oob", 0, 100)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("MoveToFront and MoveToBack", func(t *testing.T) {
+		om := New[string, int]()
+		om.Set("a", 1)
+		om.Set("b", 2)
+		om.Set("c", 3)
+
+		om.MoveToFront("c")
+		assert.Equal(t, []string{"c", "a", "b"}, om.Keys())
+
+		om.MoveToBack("c")
+		assert.Equal(t, []string{"a", "b", "c"}, om.Keys())
+
+		om.MoveToFront("missing") // no-op
+		assert.Equal(t, []string{"a", "b", "c"}, om.Keys())
+	})
+
+	t.Run("MoveBefore and MoveAfter", func(t *testing.T) {
+		om := New[string, int]()
+		om.Set("a", 1)
+		om.Set("b", 2)
+		om.Set("c", 3)
+
+		err := om.MoveBefore("c", "a")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"c", "a", "b"}, om.Keys())
+
+		err = om.MoveAfter("c", "b")
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, om.Keys())
+
+		err = om.MoveBefore("missing", "a")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("GetAt and DeleteAt", func(t *testing.T) {
+		om := New[string, int]()
+		om.Set("a", 1)
+		om.Set("b", 2)
+		om.Set("c", 3)
+
+		k, v, err := om.GetAt(1)
+		assert.Nil(t, err)
+		assert.Equal(t, "b", k)
+		assert.Equal(t, 2, v)
+
+		k, v, err = om.GetAt(-1)
+		assert.Nil(t, err)
+		assert.Equal(t, "c", k)
+		assert.Equal(t, 3, v)
+
+		_, _, err = om.GetAt(10)
+		assert.NotNil(t, err)
+
+		err = om.DeleteAt(0)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"b", "c"}, om.Keys())
+
+		err = om.DeleteAt(10)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("New with options", func(t *testing.T) {
+		om := New(WithCapacity[string, int](4), WithInitialData(
+			Pair[string, int]{Key: "a", Value: 1},
+			Pair[string, int]{Key: "b", Value: 2},
+		))
+
+		assert.Equal(t, []string{"a", "b"}, om.Keys())
+		assert.Equal(t, []int{1, 2}, om.Values())
+	})
+
+	t.Run("AddPairs", func(t *testing.T) {
+		om := New[string, int]()
+		om.AddPairs(
+			Pair[string, int]{Key: "x", Value: 10},
+			Pair[string, int]{Key: "y", Value: 20},
+		)
+
+		assert.Equal(t, []string{"x", "y"}, om.Keys())
+		assert.Equal(t, []int{10, 20}, om.Values())
+	})
+
+	t.Run("nested unmarshal preserves order for any values", func(t *testing.T) {
+		jsonInput := `{"a":{"z":1,"y":2,"x":3},"b":[{"q":1,"p":2},{"n":3,"m":4}]}`
+		om := New[string, any]()
+
+		err := json.Unmarshal([]byte(jsonInput), om)
+		assert.Nil(t, err)
+
+		nested, ok := om.GetOrDefault("a").(*orderedMap[string, any])
+		assert.True(t, ok)
+		assert.Equal(t, []string{"z", "y", "x"}, nested.Keys())
+
+		list, ok := om.GetOrDefault("b").([]*orderedMap[string, any])
+		assert.True(t, ok)
+		assert.Equal(t, []string{"q", "p"}, list[0].Keys())
+		assert.Equal(t, []string{"n", "m"}, list[1].Keys())
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		om := New[string, int]()
+		om.Set("a", 1)
+		om.Set("b", 2)
+		om.Set("c", 3)
+
+		even := Filter(om.Iter(), func(k string, v int) bool { return v%2 == 0 })
+		result := Collect(even)
+		assert.Equal(t, []string{"b"}, result.Keys())
+	})
+
+	t.Run("Map", func(t *testing.T) {
+		om := New[string, int]()
+		om.Set("a", 1)
+		om.Set("b", 2)
+
+		doubled := Map(om.Iter(), func(k string, v int) (string, int) { return k, v * 2 })
+		result := Collect(doubled)
+		assert.Equal(t, []int{2, 4}, result.Values())
+	})
+
+	t.Run("Take and Skip", func(t *testing.T) {
+		om := New[string, int]()
+		om.Set("a", 1)
+		om.Set("b", 2)
+		om.Set("c", 3)
+
+		first := Collect(Take(om.Iter(), 2))
+		assert.Equal(t, []string{"a", "b"}, first.Keys())
+
+		rest := Collect(Skip(om.Iter(), 2))
+		assert.Equal(t, []string{"c"}, rest.Keys())
+	})
 }